@@ -1,33 +1,79 @@
 package main
 
-import "fmt"
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// Coster computes the weight/cost of a value for a weighted-capacity cache
+// built with NewWeighted
+type Coster[V any] func(V) int64
 
 type Node[K comparable, V any] struct {
-	key   K
-	value V
-	prev  *Node[K, V]
-	next  *Node[K, V]
+	key       K
+	value     V
+	prev      *Node[K, V]
+	next      *Node[K, V]
+	expiresAt time.Time
+	cost      int64
+}
+
+// isExpired reports whether the node has a TTL and it has elapsed
+func (n *Node[K, V]) isExpired() bool {
+	return !n.expiresAt.IsZero() && time.Now().After(n.expiresAt)
 }
 
 type LRUCache[K comparable, V any] struct {
-	capacity int
-	cache    map[K]*Node[K, V]
-	head     *Node[K, V]
-	tail     *Node[K, V]
+	capacity   int
+	cache      map[K]*Node[K, V]
+	head       *Node[K, V]
+	tail       *Node[K, V]
+	onEvicted  func(K, V)
+	defaultTTL time.Duration
+	maxCost    int64
+	totalCost  int64
+	coster     Coster[V]
 }
 
 // Constructor creates a new LRU cache with given capacity
 func Constructor[K comparable, V any](capacity int) LRUCache[K, V] {
+	return newLRU[K, V](capacity, nil)
+}
+
+// NewWithTTL creates an LRU cache whose entries expire defaultTTL after
+// being written, unless overridden per-entry via PutWithTTL
+func NewWithTTL[K comparable, V any](capacity int, defaultTTL time.Duration) LRUCache[K, V] {
+	lru := newLRU[K, V](capacity, nil)
+	lru.defaultTTL = defaultTTL
+	return lru
+}
+
+// NewWeighted creates an LRU cache capacitated by total cost rather than
+// entry count, evicting least recently used entries while totalCost exceeds
+// maxCost. If coster is non-nil it computes the cost of entries added via
+// Put; callers that need a different cost per entry can use PutWithCost
+func NewWeighted[K comparable, V any](maxCost int64, coster Coster[V]) LRUCache[K, V] {
+	lru := newLRU[K, V](math.MaxInt, nil)
+	lru.maxCost = maxCost
+	lru.coster = coster
+	return lru
+}
+
+// newLRU builds an empty LRU cache, optionally firing onEvicted whenever an
+// entry leaves the cache by capacity eviction, overwrite, or explicit removal
+func newLRU[K comparable, V any](capacity int, onEvicted func(K, V)) LRUCache[K, V] {
 	head := &Node[K, V]{}
 	tail := &Node[K, V]{}
 	head.next = tail
 	tail.prev = head
 
 	return LRUCache[K, V]{
-		capacity: capacity,
-		cache:    make(map[K]*Node[K, V]),
-		head:     head,
-		tail:     tail,
+		capacity:  capacity,
+		cache:     make(map[K]*Node[K, V]),
+		head:      head,
+		tail:      tail,
+		onEvicted: onEvicted,
 	}
 }
 
@@ -63,7 +109,27 @@ func (lru *LRUCache[K, V]) popTail() *Node[K, V] {
 	return lastNode
 }
 
-// Get retrieves value by key, returns -1 if not found
+// RemoveOldest evicts and returns the least recently used entry, firing
+// onEvicted if one is set
+func (lru *LRUCache[K, V]) RemoveOldest() (K, V, bool) {
+	if lru.tail.prev == lru.head {
+		var zeroK K
+		var zeroV V
+		return zeroK, zeroV, false
+	}
+
+	node := lru.popTail()
+	delete(lru.cache, node.key)
+	lru.totalCost -= node.cost
+	if lru.onEvicted != nil {
+		lru.onEvicted(node.key, node.value)
+	}
+	return node.key, node.value, true
+}
+
+// Get retrieves value by key, returning the zero value and false if not
+// found, and marks the entry most recently used. An entry whose TTL
+// has elapsed is treated as a miss and evicted
 func (lru *LRUCache[K, V]) Get(key K) (V, bool) {
 	node, exists := lru.cache[key]
 	if !exists {
@@ -71,31 +137,190 @@ func (lru *LRUCache[K, V]) Get(key K) (V, bool) {
 		return zero, false
 	}
 
+	if node.isExpired() {
+		lru.removeNode(node)
+		delete(lru.cache, key)
+		lru.totalCost -= node.cost
+		if lru.onEvicted != nil {
+			lru.onEvicted(key, node.value)
+		}
+		var zero V
+		return zero, false
+	}
+
 	lru.moveToHead(node)
 	return node.value, true
 }
 
-// Put adds or updates a key-value pair
+// Put adds or updates a key-value pair, applying the cache's defaultTTL and
+// Coster (if any are set)
 func (lru *LRUCache[K, V]) Put(key K, value V) {
+	lru.putEntry(key, value, lru.defaultTTL, lru.costOf(value))
+}
+
+// PutWithTTL adds or updates a key-value pair that expires after ttl,
+// overriding the cache's defaultTTL for this entry
+func (lru *LRUCache[K, V]) PutWithTTL(key K, value V, ttl time.Duration) {
+	lru.putEntry(key, value, ttl, lru.costOf(value))
+}
+
+// PutWithCost adds or updates a key-value pair with an explicit cost,
+// overriding the cache's Coster for this entry
+func (lru *LRUCache[K, V]) PutWithCost(key K, value V, cost int64) {
+	lru.putEntry(key, value, lru.defaultTTL, cost)
+}
+
+// costOf returns the cache's default cost for value: 1 if no Coster is set
+func (lru *LRUCache[K, V]) costOf(value V) int64 {
+	if lru.coster != nil {
+		return lru.coster(value)
+	}
+	return 1
+}
+
+func (lru *LRUCache[K, V]) putEntry(key K, value V, ttl time.Duration, cost int64) {
+	var expiresAt time.Time
+	if ttl > 0 {
+		expiresAt = time.Now().Add(ttl)
+	}
+
 	node, exists := lru.cache[key]
 
 	if exists {
+		old := node.value
+		lru.totalCost += cost - node.cost
 		node.value = value
+		node.expiresAt = expiresAt
+		node.cost = cost
 		lru.moveToHead(node)
+		if lru.onEvicted != nil {
+			lru.onEvicted(key, old)
+		}
+		lru.evictByCost()
 		return
 	}
 
 	newNode := &Node[K, V]{
-		key:   key,
-		value: value,
+		key:       key,
+		value:     value,
+		expiresAt: expiresAt,
+		cost:      cost,
 	}
 	if len(lru.cache) >= lru.capacity {
 		tail := lru.popTail()
 		delete(lru.cache, tail.key)
+		lru.totalCost -= tail.cost
+		if lru.onEvicted != nil {
+			lru.onEvicted(tail.key, tail.value)
+		}
 	}
 
 	lru.cache[key] = newNode
 	lru.addNode(newNode)
+	lru.totalCost += cost
+	lru.evictByCost()
+}
+
+// evictByCost evicts least recently used entries while totalCost exceeds
+// maxCost; caches without a maxCost set (the common entry-count mode) skip
+// this entirely
+func (lru *LRUCache[K, V]) evictByCost() {
+	if lru.maxCost <= 0 {
+		return
+	}
+	for lru.totalCost > lru.maxCost && lru.tail.prev != lru.head {
+		tail := lru.popTail()
+		delete(lru.cache, tail.key)
+		lru.totalCost -= tail.cost
+		if lru.onEvicted != nil {
+			lru.onEvicted(tail.key, tail.value)
+		}
+	}
+}
+
+// TotalCost returns the sum of costs for all entries currently stored
+func (lru *LRUCache[K, V]) TotalCost() int64 {
+	return lru.totalCost
+}
+
+// Peek returns the value for key without updating its recency. An entry
+// whose TTL has elapsed is treated as a miss, same as Get
+func (lru *LRUCache[K, V]) Peek(key K) (V, bool) {
+	node, exists := lru.cache[key]
+	if !exists || node.isExpired() {
+		var zero V
+		return zero, false
+	}
+	return node.value, true
+}
+
+// Contains reports whether key is present without updating its recency. An
+// entry whose TTL has elapsed is treated as absent, same as Get
+func (lru *LRUCache[K, V]) Contains(key K) bool {
+	node, exists := lru.cache[key]
+	return exists && !node.isExpired()
+}
+
+// Remove deletes key from the cache, firing onEvicted if one is set
+func (lru *LRUCache[K, V]) Remove(key K) bool {
+	node, exists := lru.cache[key]
+	if !exists {
+		return false
+	}
+
+	lru.removeNode(node)
+	delete(lru.cache, key)
+	lru.totalCost -= node.cost
+	if lru.onEvicted != nil {
+		lru.onEvicted(node.key, node.value)
+	}
+	return true
+}
+
+// Len returns the number of entries currently stored
+func (lru *LRUCache[K, V]) Len() int {
+	return len(lru.cache)
+}
+
+// Keys returns all keys ordered from least to most recently used
+func (lru *LRUCache[K, V]) Keys() []K {
+	keys := make([]K, 0, len(lru.cache))
+	for node := lru.tail.prev; node != lru.head; node = node.prev {
+		keys = append(keys, node.key)
+	}
+	return keys
+}
+
+// Purge removes all entries, firing onEvicted for each one if set
+func (lru *LRUCache[K, V]) Purge() {
+	for key, node := range lru.cache {
+		if lru.onEvicted != nil {
+			lru.onEvicted(key, node.value)
+		}
+		delete(lru.cache, key)
+	}
+	lru.head.next = lru.tail
+	lru.tail.prev = lru.head
+	lru.totalCost = 0
+}
+
+// reapExpired walks the whole list evicting any entry whose TTL has elapsed.
+// LRUCache has no locking of its own, so callers running this from a
+// background goroutine (SyncLRUCache's janitor) must hold their own lock
+func (lru *LRUCache[K, V]) reapExpired() {
+	node := lru.tail.prev
+	for node != lru.head {
+		prev := node.prev
+		if node.isExpired() {
+			lru.removeNode(node)
+			delete(lru.cache, node.key)
+			lru.totalCost -= node.cost
+			if lru.onEvicted != nil {
+				lru.onEvicted(node.key, node.value)
+			}
+		}
+		node = prev
+	}
 }
 
 // Display shows current cache state (for debugging)
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLRUCacheTTLExpiryIsAMiss(t *testing.T) {
+	tests := []struct {
+		name string
+		put  func(c *LRUCache[string, int])
+	}{
+		{
+			name: "PutWithTTL",
+			put: func(c *LRUCache[string, int]) {
+				c.PutWithTTL("a", 1, time.Millisecond)
+			},
+		},
+		{
+			name: "defaultTTL from NewWithTTL",
+			put: func(c *LRUCache[string, int]) {
+				c.Put("a", 1)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewWithTTL[string, int](2, time.Millisecond)
+			tt.put(&c)
+			time.Sleep(5 * time.Millisecond)
+
+			if _, ok := c.Get("a"); ok {
+				t.Fatalf("Get should treat an expired entry as a miss")
+			}
+		})
+	}
+}
+
+// Regression test: Peek and Contains must agree with Get about expiry,
+// otherwise a caller that checks Contains/Peek before Get sees stale data.
+func TestLRUCachePeekContainsAgreeWithGetOnExpiry(t *testing.T) {
+	c := Constructor[string, int](2)
+	c.PutWithTTL("a", 1, time.Millisecond)
+	time.Sleep(5 * time.Millisecond)
+
+	if _, ok := c.Peek("a"); ok {
+		t.Fatalf("Peek should treat an expired entry as a miss")
+	}
+	if c.Contains("a") {
+		t.Fatalf("Contains should treat an expired entry as absent")
+	}
+	if _, ok := c.Get("a"); ok {
+		t.Fatalf("Get should treat an expired entry as a miss")
+	}
+}
+
+func TestSyncLRUCacheJanitorReapsExpiredEntries(t *testing.T) {
+	c := NewSyncWithTTL[string, int](10, time.Millisecond)
+	c.Put("a", 1)
+
+	c.StartJanitor(2 * time.Millisecond)
+	defer c.Close()
+
+	time.Sleep(20 * time.Millisecond)
+
+	if c.Len() != 0 {
+		t.Fatalf("Len() = %d, want 0 after the janitor reaps the expired entry", c.Len())
+	}
+}
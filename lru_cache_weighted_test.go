@@ -0,0 +1,57 @@
+package main
+
+import "testing"
+
+func TestLRUCacheWeightedEviction(t *testing.T) {
+	tests := []struct {
+		name     string
+		maxCost  int64
+		coster   Coster[string]
+		ops      func(c *LRUCache[string, string])
+		wantKeys []string
+		wantGone []string
+	}{
+		{
+			name:    "evicts least recently used while totalCost exceeds maxCost",
+			maxCost: 5,
+			coster:  func(v string) int64 { return int64(len(v)) },
+			ops: func(c *LRUCache[string, string]) {
+				c.Put("a", "xx")  // cost 2, total 2
+				c.Put("b", "xxx") // cost 3, total 5
+				c.Put("c", "xx")  // cost 2, total 7 > 5, evicts a (oldest)
+			},
+			wantKeys: []string{"b", "c"},
+			wantGone: []string{"a"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewWeighted[string, string](tt.maxCost, tt.coster)
+			tt.ops(&c)
+
+			for _, k := range tt.wantKeys {
+				if _, ok := c.Get(k); !ok {
+					t.Errorf("expected key %q to remain", k)
+				}
+			}
+			for _, k := range tt.wantGone {
+				if _, ok := c.Get(k); ok {
+					t.Errorf("expected key %q to be evicted", k)
+				}
+			}
+			if c.TotalCost() > tt.maxCost {
+				t.Errorf("TotalCost() = %d, want <= %d", c.TotalCost(), tt.maxCost)
+			}
+		})
+	}
+}
+
+func TestLRUCachePutWithCostOverridesCoster(t *testing.T) {
+	c := NewWeighted[string, string](10, func(string) int64 { return 1 })
+	c.PutWithCost("a", "x", 7)
+
+	if got := c.TotalCost(); got != 7 {
+		t.Fatalf("TotalCost() = %d, want 7", got)
+	}
+}
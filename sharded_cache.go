@@ -0,0 +1,89 @@
+package main
+
+import (
+	"fmt"
+	"hash/fnv"
+)
+
+// ShardedCache fans requests across a power-of-two number of independently
+// locked LRU shards, keyed by an fnv hash of the key, so lock contention
+// scales with the number of shards instead of serializing on one mutex.
+// This uses fnv + fmt.Fprintf rather than maphash.Comparable (added in Go
+// 1.24) because the repo has no go.mod pinning a minimum Go version
+type ShardedCache[K comparable, V any] struct {
+	shards []*SyncLRUCache[K, V]
+	mask   uint32
+}
+
+// NewSharded creates a sharded cache with shardCount shards (rounded up to
+// the next power of two), each an independent LRU cache of capacityPerShard
+func NewSharded[K comparable, V any](shardCount int, capacityPerShard int) *ShardedCache[K, V] {
+	shardCount = nextPowerOfTwo(shardCount)
+	shards := make([]*SyncLRUCache[K, V], shardCount)
+	for i := range shards {
+		shards[i] = New[K, V](capacityPerShard)
+	}
+
+	return &ShardedCache[K, V]{
+		shards: shards,
+		mask:   uint32(shardCount - 1),
+	}
+}
+
+func nextPowerOfTwo(n int) int {
+	if n < 1 {
+		return 1
+	}
+	p := 1
+	for p < n {
+		p <<= 1
+	}
+	return p
+}
+
+// shardFor returns the shard responsible for key
+func (s *ShardedCache[K, V]) shardFor(key K) *SyncLRUCache[K, V] {
+	h := fnv.New32a()
+	fmt.Fprintf(h, "%v", key)
+	return s.shards[h.Sum32()&s.mask]
+}
+
+// Get retrieves value by key from its shard
+func (s *ShardedCache[K, V]) Get(key K) (V, bool) {
+	return s.shardFor(key).Get(key)
+}
+
+// Put adds or updates a key-value pair in its shard
+func (s *ShardedCache[K, V]) Put(key K, value V) {
+	s.shardFor(key).Put(key, value)
+}
+
+// Remove deletes key from its shard
+func (s *ShardedCache[K, V]) Remove(key K) bool {
+	return s.shardFor(key).Remove(key)
+}
+
+// Len returns the total number of entries across all shards
+func (s *ShardedCache[K, V]) Len() int {
+	total := 0
+	for _, shard := range s.shards {
+		total += shard.Len()
+	}
+	return total
+}
+
+// Keys returns the keys from all shards, shard order then per-shard LRU order
+func (s *ShardedCache[K, V]) Keys() []K {
+	keys := make([]K, 0, s.Len())
+	for _, shard := range s.shards {
+		keys = append(keys, shard.Keys()...)
+	}
+	return keys
+}
+
+// Purge removes all entries from every shard
+func (s *ShardedCache[K, V]) Purge() {
+	for _, shard := range s.shards {
+		shard.Purge()
+	}
+}
@@ -0,0 +1,38 @@
+package main
+
+import "testing"
+
+func TestShardedCacheAggregatesAcrossShards(t *testing.T) {
+	sc := NewSharded[string, int](4, 10)
+
+	keys := []string{"a", "b", "c", "d", "e", "f", "g", "h"}
+	for i, k := range keys {
+		sc.Put(k, i)
+	}
+
+	if got := sc.Len(); got != len(keys) {
+		t.Fatalf("Len() = %d, want %d", got, len(keys))
+	}
+
+	for i, k := range keys {
+		if v, ok := sc.Get(k); !ok || v != i {
+			t.Errorf("Get(%q) = %v, %v, want %d, true", k, v, ok, i)
+		}
+	}
+
+	if got := len(sc.Keys()); got != len(keys) {
+		t.Fatalf("len(Keys()) = %d, want %d", got, len(keys))
+	}
+
+	sc.Purge()
+	if got := sc.Len(); got != 0 {
+		t.Fatalf("Len() after Purge = %d, want 0", got)
+	}
+}
+
+func TestShardedCacheRoundsShardCountToPowerOfTwo(t *testing.T) {
+	sc := NewSharded[string, int](3, 10)
+	if got := len(sc.shards); got != 4 {
+		t.Fatalf("shard count = %d, want 4 (next power of two after 3)", got)
+	}
+}
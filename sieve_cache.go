@@ -0,0 +1,136 @@
+package main
+
+// SieveNode is a node in a SieveCache's FIFO list, carrying the single
+// "visited" bit the SIEVE algorithm uses in place of LRU's move-to-head
+type SieveNode[K comparable, V any] struct {
+	key     K
+	value   V
+	visited bool
+	prev    *SieveNode[K, V]
+	next    *SieveNode[K, V]
+}
+
+// SieveCache implements the SIEVE eviction policy: a single FIFO list plus a
+// "hand" pointer that remembers where the last eviction scan left off. Unlike
+// LRUCache, a Get never reorders the list - it only flips the visited bit -
+// which keeps reads cheap and the policy simple.
+type SieveCache[K comparable, V any] struct {
+	capacity int
+	cache    map[K]*SieveNode[K, V]
+	head     *SieveNode[K, V] // most recently inserted
+	tail     *SieveNode[K, V] // least recently inserted
+	hand     *SieveNode[K, V]
+}
+
+// NewSieveCache creates a new SIEVE cache with given capacity
+func NewSieveCache[K comparable, V any](capacity int) *SieveCache[K, V] {
+	return &SieveCache[K, V]{
+		capacity: capacity,
+		cache:    make(map[K]*SieveNode[K, V]),
+	}
+}
+
+// addNode inserts node at the head of the FIFO list
+func (s *SieveCache[K, V]) addNode(node *SieveNode[K, V]) {
+	node.prev = nil
+	node.next = s.head
+	if s.head != nil {
+		s.head.prev = node
+	}
+	s.head = node
+	if s.tail == nil {
+		s.tail = node
+	}
+}
+
+// removeNode unlinks node from the FIFO list, fixing up the hand if it
+// currently points at the node being removed
+func (s *SieveCache[K, V]) removeNode(node *SieveNode[K, V]) {
+	if s.hand == node {
+		s.hand = node.prev
+	}
+
+	if node.prev != nil {
+		node.prev.next = node.next
+	} else {
+		s.head = node.next
+	}
+
+	if node.next != nil {
+		node.next.prev = node.prev
+	} else {
+		s.tail = node.prev
+	}
+}
+
+// evict runs the SIEVE eviction scan: starting from the hand (or the tail if
+// the hand is nil), walk backwards clearing visited bits until an
+// unvisited node is found, then remove it
+func (s *SieveCache[K, V]) evict() {
+	node := s.hand
+	if node == nil {
+		node = s.tail
+	}
+
+	for node != nil && node.visited {
+		node.visited = false
+		node = node.prev
+		if node == nil {
+			node = s.tail
+		}
+	}
+
+	if node == nil {
+		return
+	}
+
+	s.hand = node.prev
+	s.removeNode(node)
+	delete(s.cache, node.key)
+}
+
+// Get retrieves value by key, marking it visited
+func (s *SieveCache[K, V]) Get(key K) (V, bool) {
+	node, exists := s.cache[key]
+	if !exists {
+		var zero V
+		return zero, false
+	}
+
+	node.visited = true
+	return node.value, true
+}
+
+// Put adds or updates a key-value pair
+func (s *SieveCache[K, V]) Put(key K, value V) {
+	if node, exists := s.cache[key]; exists {
+		node.value = value
+		node.visited = true
+		return
+	}
+
+	if len(s.cache) >= s.capacity {
+		s.evict()
+	}
+
+	node := &SieveNode[K, V]{key: key, value: value}
+	s.cache[key] = node
+	s.addNode(node)
+}
+
+// Remove deletes key from the cache
+func (s *SieveCache[K, V]) Remove(key K) bool {
+	node, exists := s.cache[key]
+	if !exists {
+		return false
+	}
+
+	s.removeNode(node)
+	delete(s.cache, key)
+	return true
+}
+
+// Len returns the number of entries currently stored
+func (s *SieveCache[K, V]) Len() int {
+	return len(s.cache)
+}
@@ -0,0 +1,60 @@
+package main
+
+import "testing"
+
+func TestSieveCacheEviction(t *testing.T) {
+	tests := []struct {
+		name        string
+		capacity    int
+		ops         func(c *SieveCache[string, int])
+		wantKeys    []string
+		wantMissing []string
+	}{
+		{
+			name:     "visited entries are spared, unvisited evicted first",
+			capacity: 3,
+			ops: func(c *SieveCache[string, int]) {
+				c.Put("a", 1)
+				c.Put("b", 2)
+				c.Put("c", 3)
+				c.Get("a") // mark a visited
+				c.Get("b") // mark b visited
+				c.Put("d", 4) // c is the only unvisited node -> evicted
+			},
+			wantKeys:    []string{"a", "b", "d"},
+			wantMissing: []string{"c"},
+		},
+		{
+			name:     "hand resumes scanning from where the last eviction left off",
+			capacity: 3,
+			ops: func(c *SieveCache[string, int]) {
+				c.Put("a", 1)
+				c.Put("b", 2)
+				c.Put("c", 3)
+				c.Get("a")    // mark a visited
+				c.Put("d", 4) // clears a's bit, evicts b, hand moves to c
+				c.Put("e", 5) // scan resumes at the hand: evicts c, not a at the tail
+			},
+			wantKeys:    []string{"a", "d", "e"},
+			wantMissing: []string{"b", "c"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewSieveCache[string, int](tt.capacity)
+			tt.ops(c)
+
+			for _, k := range tt.wantKeys {
+				if _, ok := c.Get(k); !ok {
+					t.Errorf("expected key %q to remain in cache", k)
+				}
+			}
+			for _, k := range tt.wantMissing {
+				if _, ok := c.Get(k); ok {
+					t.Errorf("expected key %q to be evicted", k)
+				}
+			}
+		})
+	}
+}
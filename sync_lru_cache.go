@@ -0,0 +1,140 @@
+package main
+
+import (
+	"sync"
+	"time"
+)
+
+// SyncLRUCache is a thread-safe LRU cache guarding an LRUCache with a
+// sync.RWMutex, similar in shape to hashicorp/golang-lru's Cache wrapping
+// simplelru.LRU.
+type SyncLRUCache[K comparable, V any] struct {
+	mu          sync.RWMutex
+	lru         LRUCache[K, V]
+	janitorStop chan struct{}
+}
+
+// New creates a new thread-safe LRU cache with given capacity
+func New[K comparable, V any](capacity int) *SyncLRUCache[K, V] {
+	return &SyncLRUCache[K, V]{lru: newLRU[K, V](capacity, nil)}
+}
+
+// NewWithEvict creates a thread-safe LRU cache that invokes onEvicted
+// whenever an entry leaves the cache due to capacity eviction, overwrite,
+// or explicit removal
+func NewWithEvict[K comparable, V any](capacity int, onEvicted func(K, V)) *SyncLRUCache[K, V] {
+	return &SyncLRUCache[K, V]{lru: newLRU[K, V](capacity, onEvicted)}
+}
+
+// NewSyncWithTTL creates a thread-safe LRU cache whose entries expire
+// defaultTTL after being written, unless overridden per-entry via PutWithTTL
+func NewSyncWithTTL[K comparable, V any](capacity int, defaultTTL time.Duration) *SyncLRUCache[K, V] {
+	lru := newLRU[K, V](capacity, nil)
+	lru.defaultTTL = defaultTTL
+	return &SyncLRUCache[K, V]{lru: lru}
+}
+
+// Get retrieves value by key, marking it most recently used
+func (c *SyncLRUCache[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Get(key)
+}
+
+// Put adds or updates a key-value pair
+func (c *SyncLRUCache[K, V]) Put(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.lru.Put(key, value)
+}
+
+// Peek returns the value for key without updating its recency
+func (c *SyncLRUCache[K, V]) Peek(key K) (V, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.lru.Peek(key)
+}
+
+// Contains reports whether key is present without updating its recency
+func (c *SyncLRUCache[K, V]) Contains(key K) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.lru.Contains(key)
+}
+
+// Remove deletes key from the cache, firing onEvicted if one is set
+func (c *SyncLRUCache[K, V]) Remove(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Remove(key)
+}
+
+// Len returns the number of entries currently stored
+func (c *SyncLRUCache[K, V]) Len() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.lru.Len()
+}
+
+// Keys returns all keys ordered from least to most recently used
+func (c *SyncLRUCache[K, V]) Keys() []K {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.lru.Keys()
+}
+
+// Purge removes all entries, firing onEvicted for each one if set
+func (c *SyncLRUCache[K, V]) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.lru.Purge()
+}
+
+// PutWithTTL adds or updates a key-value pair that expires after ttl,
+// overriding the cache's defaultTTL for this entry
+func (c *SyncLRUCache[K, V]) PutWithTTL(key K, value V, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.lru.PutWithTTL(key, value, ttl)
+}
+
+// StartJanitor starts a background goroutine that proactively evicts
+// expired entries every interval, so memory doesn't balloon when reads are
+// rare. Call Close to stop it. Calling StartJanitor again while one is
+// already running is a no-op
+func (c *SyncLRUCache[K, V]) StartJanitor(interval time.Duration) {
+	c.mu.Lock()
+	if c.janitorStop != nil {
+		c.mu.Unlock()
+		return
+	}
+	stop := make(chan struct{})
+	c.janitorStop = stop
+	c.mu.Unlock()
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				c.mu.Lock()
+				c.lru.reapExpired()
+				c.mu.Unlock()
+			case <-stop:
+				return
+			}
+		}
+	}()
+}
+
+// Close stops the background janitor, if one is running
+func (c *SyncLRUCache[K, V]) Close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.janitorStop == nil {
+		return
+	}
+	close(c.janitorStop)
+	c.janitorStop = nil
+}
@@ -0,0 +1,81 @@
+package main
+
+import "testing"
+
+func TestSyncLRUCacheEviction(t *testing.T) {
+	tests := []struct {
+		name      string
+		capacity  int
+		ops       func(c *SyncLRUCache[string, int])
+		wantEvict []string
+	}{
+		{
+			name:     "capacity eviction evicts least recently used",
+			capacity: 2,
+			ops: func(c *SyncLRUCache[string, int]) {
+				c.Put("a", 1)
+				c.Put("b", 2)
+				c.Put("c", 3) // evicts a
+			},
+			wantEvict: []string{"a"},
+		},
+		{
+			name:     "overwrite fires the callback for the old value",
+			capacity: 2,
+			ops: func(c *SyncLRUCache[string, int]) {
+				c.Put("a", 1)
+				c.Put("a", 2)
+			},
+			wantEvict: []string{"a"},
+		},
+		{
+			name:     "explicit remove fires the callback",
+			capacity: 2,
+			ops: func(c *SyncLRUCache[string, int]) {
+				c.Put("a", 1)
+				c.Remove("a")
+			},
+			wantEvict: []string{"a"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var evicted []string
+			c := NewWithEvict[string, int](tt.capacity, func(k string, _ int) {
+				evicted = append(evicted, k)
+			})
+			tt.ops(c)
+
+			if len(evicted) != len(tt.wantEvict) {
+				t.Fatalf("evicted = %v, want %v", evicted, tt.wantEvict)
+			}
+			for i, k := range tt.wantEvict {
+				if evicted[i] != k {
+					t.Fatalf("evicted[%d] = %q, want %q", i, evicted[i], k)
+				}
+			}
+		})
+	}
+}
+
+func TestSyncLRUCachePeekContainsLenPurge(t *testing.T) {
+	c := New[string, int](2)
+	c.Put("a", 1)
+	c.Put("b", 2)
+
+	if v, ok := c.Peek("a"); !ok || v != 1 {
+		t.Fatalf("Peek(a) = %v, %v, want 1, true", v, ok)
+	}
+	if !c.Contains("b") {
+		t.Fatalf("Contains(b) = false, want true")
+	}
+	if c.Len() != 2 {
+		t.Fatalf("Len() = %d, want 2", c.Len())
+	}
+
+	c.Purge()
+	if c.Len() != 0 {
+		t.Fatalf("Len() after Purge = %d, want 0", c.Len())
+	}
+}
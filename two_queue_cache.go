@@ -0,0 +1,122 @@
+package main
+
+// Default fractions of total capacity given to the recent and ghost queues,
+// matching the ratios from the original 2Q paper.
+const (
+	default2QRecentRatio = 0.25
+	default2QGhostRatio  = 0.5
+)
+
+// TwoQueueCache implements the 2Q eviction algorithm, trading a single LRU
+// list for three: recent holds entries seen once, frequent holds entries
+// that have been accessed a second time, and recentEvict is a ghost list
+// that remembers just the keys evicted from recent so a second Put can
+// recognize a frequency signal and promote straight into frequent. This
+// gives scan resistance the plain LRUCache lacks.
+type TwoQueueCache[K comparable, V any] struct {
+	capacity    int
+	recentSize  int
+	recent      LRUCache[K, V]
+	frequent    LRUCache[K, V]
+	recentEvict LRUCache[K, struct{}]
+}
+
+// New2QParams creates a 2Q cache with tunable recent/ghost queue ratios.
+// recentRatio and ghostRatio are fractions of capacity, e.g. 0.25 and 0.5
+func New2QParams[K comparable, V any](capacity int, recentRatio, ghostRatio float64) *TwoQueueCache[K, V] {
+	recentSize := int(float64(capacity) * recentRatio)
+	if recentSize < 1 {
+		recentSize = 1
+	}
+	ghostSize := int(float64(capacity) * ghostRatio)
+	if ghostSize < 1 {
+		ghostSize = 1
+	}
+
+	return &TwoQueueCache[K, V]{
+		capacity:   capacity,
+		recentSize: recentSize,
+		// recent and frequent are both given headroom above their nominal
+		// size so LRUCache's own capacity-triggered eviction never preempts
+		// trimRecent/trimFrequent, which do the real bookkeeping (ghost-list
+		// insertion for recent, total-size check for frequent)
+		recent:      newLRU[K, V](capacity+recentSize, nil),
+		frequent:    newLRU[K, V](capacity+recentSize, nil),
+		recentEvict: newLRU[K, struct{}](ghostSize, nil),
+	}
+}
+
+// New2Q creates a 2Q cache with the default recent/ghost queue ratios
+func New2Q[K comparable, V any](capacity int) *TwoQueueCache[K, V] {
+	return New2QParams[K, V](capacity, default2QRecentRatio, default2QGhostRatio)
+}
+
+// trimRecent evicts entries from recent once it exceeds its share of the
+// capacity, pushing each evicted key into the ghost list
+func (q *TwoQueueCache[K, V]) trimRecent() {
+	for q.recent.Len() > q.recentSize {
+		key, _, ok := q.recent.RemoveOldest()
+		if !ok {
+			break
+		}
+		q.recentEvict.Put(key, struct{}{})
+	}
+}
+
+// trimFrequent evicts from frequent until the total live size is back
+// within capacity; recent is kept to its own size by trimRecent, so any
+// excess is taken from frequent
+func (q *TwoQueueCache[K, V]) trimFrequent() {
+	for q.recent.Len()+q.frequent.Len() > q.capacity {
+		if _, _, ok := q.frequent.RemoveOldest(); !ok {
+			break
+		}
+	}
+}
+
+// Get retrieves value by key. A hit in frequent stays in frequent; a hit in
+// recent is promoted into frequent, which is what makes 2Q scan resistant
+func (q *TwoQueueCache[K, V]) Get(key K) (V, bool) {
+	if value, ok := q.frequent.Get(key); ok {
+		return value, true
+	}
+
+	if value, ok := q.recent.Peek(key); ok {
+		q.recent.Remove(key)
+		q.frequent.Put(key, value)
+		q.trimFrequent()
+		return value, true
+	}
+
+	var zero V
+	return zero, false
+}
+
+// Put adds or updates a key-value pair
+func (q *TwoQueueCache[K, V]) Put(key K, value V) {
+	if q.frequent.Contains(key) {
+		q.frequent.Put(key, value)
+		return
+	}
+
+	if q.recent.Contains(key) {
+		q.recent.Put(key, value)
+		return
+	}
+
+	if q.recentEvict.Contains(key) {
+		q.recentEvict.Remove(key)
+		q.frequent.Put(key, value)
+		q.trimFrequent()
+		return
+	}
+
+	q.recent.Put(key, value)
+	q.trimRecent()
+	q.trimFrequent()
+}
+
+// Len returns the number of live entries across the recent and frequent queues
+func (q *TwoQueueCache[K, V]) Len() int {
+	return q.recent.Len() + q.frequent.Len()
+}
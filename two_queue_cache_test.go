@@ -0,0 +1,55 @@
+package main
+
+import "testing"
+
+func TestTwoQueueCachePromotionOnSecondAccess(t *testing.T) {
+	q := New2QParams[string, int](4, 0.5, 0.5) // recentSize=2, ghostSize=2
+
+	q.Put("a", 1)
+	q.Put("b", 2)
+
+	if q.frequent.Contains("a") {
+		t.Fatalf("a should still be in recent before a second access")
+	}
+
+	if v, ok := q.Get("a"); !ok || v != 1 {
+		t.Fatalf("Get(a) = %v, %v, want 1, true", v, ok)
+	}
+
+	if !q.frequent.Contains("a") {
+		t.Fatalf("a should be promoted to frequent after a second access")
+	}
+	if q.recent.Contains("a") {
+		t.Fatalf("a should have been removed from recent after promotion")
+	}
+}
+
+func TestTwoQueueCacheGhostHitPromotesDirectlyToFrequent(t *testing.T) {
+	q := New2QParams[string, int](2, 0.5, 1.0) // recentSize=1, ghostSize=2
+
+	q.Put("a", 1) // recent: [a]
+	q.Put("b", 2) // recent exceeds its size, evicts a into the ghost list
+
+	if !q.recentEvict.Contains("a") {
+		t.Fatalf("a should be in the ghost list after recent eviction")
+	}
+
+	q.Put("a", 10) // a is in the ghost list -> promote straight to frequent
+
+	if !q.frequent.Contains("a") {
+		t.Fatalf("a should be promoted straight to frequent on a ghost hit")
+	}
+	if v, ok := q.Get("a"); !ok || v != 10 {
+		t.Fatalf("Get(a) = %v, %v, want 10, true", v, ok)
+	}
+}
+
+func TestTwoQueueCacheLen(t *testing.T) {
+	q := New2Q[string, int](4) // recentSize=1
+	q.Put("a", 1)
+	q.Put("b", 2) // recent exceeds its size, a moves to the ghost list
+
+	if got := q.Len(); got != 1 {
+		t.Fatalf("Len() = %d, want 1", got)
+	}
+}